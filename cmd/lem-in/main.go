@@ -0,0 +1,65 @@
+// Command lem-in reads a colony file, routes ants across it via the fewest
+// turns possible, and prints (or visualizes) their movements.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/zainab49/lem-in/pkg/colony"
+	"github.com/zainab49/lem-in/pkg/pathfind"
+	"github.com/zainab49/lem-in/pkg/sim"
+)
+
+func main() {
+	visualize := flag.Bool("play", false, "animate the simulation in an interactive terminal visualizer")
+	flag.BoolVar(visualize, "visualize", false, "alias for -play")
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		fmt.Println("Usage: lem-in [-play] <input_file>")
+		return
+	}
+
+	filename := flag.Arg(0)
+
+	// Parse the input file
+	graph, numAnts, err := colony.ParseInput(filename)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Find a maximal set of vertex-disjoint paths so ants can move in parallel
+	paths, err := pathfind.DisjointPaths(graph)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	assignment := pathfind.AssignAnts(paths, numAnts)
+
+	if *visualize {
+		if err := runVisualizer(graph, paths, assignment); err != nil {
+			fmt.Println(err)
+		}
+		return
+	}
+
+	printMoves(paths, assignment)
+}
+
+// printMoves drives the simulation and prints each turn's moves as
+// space-separated "Lx-room" tokens, one line per turn.
+func printMoves(paths [][]string, assignment []int) {
+	ctx := context.Background()
+	for moves := range sim.Run(ctx, paths, assignment) {
+		tokens := make([]string, len(moves))
+		for i, mv := range moves {
+			tokens[i] = fmt.Sprintf("L%d-%s", mv.AntID, mv.Room)
+		}
+		fmt.Println(strings.Join(tokens, " "))
+	}
+}
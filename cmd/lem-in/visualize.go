@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	termbox "github.com/nsf/termbox-go"
+
+	"github.com/zainab49/lem-in/pkg/colony"
+	"github.com/zainab49/lem-in/pkg/sim"
+)
+
+// gridPos scales a room's parsed X,Y coordinates into a terminal cell,
+// leaving a one-cell margin so glyphs never land on the border.
+func gridPos(rooms map[string]*colony.Room, name string, w, h int) (int, int) {
+	minX, minY, maxX, maxY := rooms[name].X, rooms[name].Y, rooms[name].X, rooms[name].Y
+	for _, r := range rooms {
+		if r.X < minX {
+			minX = r.X
+		}
+		if r.X > maxX {
+			maxX = r.X
+		}
+		if r.Y < minY {
+			minY = r.Y
+		}
+		if r.Y > maxY {
+			maxY = r.Y
+		}
+	}
+
+	r := rooms[name]
+	x, y := 1, 1
+	if maxX > minX {
+		x = 1 + (r.X-minX)*(w-3)/(maxX-minX)
+	}
+	if maxY > minY {
+		y = 1 + (r.Y-minY)*(h-3)/(maxY-minY)
+	}
+	return x, y
+}
+
+// drawLine draws a straight line of '.' cells between two grid points,
+// representing a tunnel between rooms.
+func drawLine(x0, y0, x1, y1 int) {
+	dx, dy := x1-x0, y1-y0
+	steps := dx
+	if steps < 0 {
+		steps = -steps
+	}
+	if dy > steps {
+		steps = dy
+	} else if -dy > steps {
+		steps = -dy
+	}
+	if steps == 0 {
+		return
+	}
+	for i := 1; i < steps; i++ {
+		x := x0 + dx*i/steps
+		y := y0 + dy*i/steps
+		termbox.SetCell(x, y, '.', termbox.ColorDefault, termbox.ColorDefault)
+	}
+}
+
+// drawColony redraws the whole grid: tunnels, rooms, ##start/##end, and
+// every ant at its last-known room.
+func drawColony(g *colony.Graph, antRoom map[int]string) {
+	termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	w, h := termbox.Size()
+	rooms := g.AllRooms()
+
+	for from, neighbors := range g.Tunnels {
+		x0, y0 := gridPos(rooms, from, w, h)
+		for _, to := range neighbors {
+			x1, y1 := gridPos(rooms, to, w, h)
+			drawLine(x0, y0, x1, y1)
+		}
+	}
+
+	for name := range rooms {
+		x, y := gridPos(rooms, name, w, h)
+		glyph, color := 'o', termbox.ColorDefault
+		switch name {
+		case g.Start.Name:
+			glyph, color = 'S', termbox.ColorGreen
+		case g.End.Name:
+			glyph, color = 'E', termbox.ColorRed
+		}
+		termbox.SetCell(x, y, glyph, color, termbox.ColorDefault)
+	}
+
+	for id, room := range antRoom {
+		if room == g.End.Name {
+			continue // ants that have arrived no longer occupy the grid
+		}
+		x, y := gridPos(rooms, room, w, h)
+		termbox.SetCell(x, y, rune('0'+id%10), termbox.ColorYellow, termbox.ColorDefault)
+	}
+
+	termbox.Flush()
+}
+
+// runVisualizer drives sim.Run through an interactive termbox TUI instead of
+// printing moves: space steps one turn, 'a' toggles auto-advance, 'q' quits.
+func runVisualizer(g *colony.Graph, paths [][]string, assignment []int) error {
+	if err := termbox.Init(); err != nil {
+		return err
+	}
+	defer termbox.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// termbox.PollEvent blocks, so read it on its own goroutine and feed a
+	// channel; that lets the wait loop below select between an incoming key
+	// and an auto-advance timer, keeping 'q' and 'a' live during auto-play.
+	events := make(chan termbox.Event)
+	go func() {
+		for {
+			events <- termbox.PollEvent()
+		}
+	}()
+
+	antRoom := make(map[int]string)
+	auto := false
+
+	drawColony(g, antRoom)
+
+	for moves := range sim.Run(ctx, paths, assignment) {
+		for _, mv := range moves {
+			antRoom[mv.AntID] = mv.Room
+		}
+		drawColony(g, antRoom)
+
+		quit := false
+		for waiting := true; waiting; {
+			var autoTick <-chan time.Time
+			if auto {
+				autoTick = time.After(300 * time.Millisecond)
+			}
+
+			select {
+			case ev := <-events:
+				if ev.Type != termbox.EventKey {
+					continue
+				}
+				switch {
+				case ev.Key == termbox.KeyEsc || ev.Ch == 'q':
+					quit, waiting = true, false
+				case ev.Ch == 'a':
+					auto = !auto
+					if auto {
+						// Entering auto-advance: move on to the next turn,
+						// which will then wait on the auto-advance timer.
+						waiting = false
+					}
+					// Leaving auto-advance: keep waiting, now for manual
+					// 'space'/'q' input instead of the timer.
+				case ev.Key == termbox.KeySpace:
+					waiting = false
+				}
+			case <-autoTick:
+				waiting = false
+			}
+		}
+
+		if quit {
+			cancel()
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+// Package colony parses lem-in colony files into an in-memory graph of
+// rooms and tunnels.
+package colony
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Room represents a regular room in the ant colony.
+type Room struct {
+	Name     string
+	X, Y     int
+	Occupied bool
+}
+
+// StartRoom represents the start room (##start).
+type StartRoom struct {
+	Room
+}
+
+// EndRoom represents the end room (##end).
+type EndRoom struct {
+	Room
+}
+
+// Graph represents the colony with rooms and tunnels.
+type Graph struct {
+	Rooms   map[string]*Room
+	Tunnels map[string][]string
+	Start   *StartRoom
+	End     *EndRoom
+}
+
+// Error messages
+var (
+	ErrInvalidFormat   = errors.New("ERROR: invalid data format")
+	ErrMissingStartEnd = errors.New("ERROR: missing ##start or ##end")
+	ErrNoPath          = errors.New("ERROR: no path found between ##start and ##end")
+)
+
+// ParseError reports a malformed line found while parsing a colony file,
+// including the offending line number and text so the caller can point the
+// user at exactly what to fix.
+type ParseError struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ERROR: invalid data format: line %d: %s: %q", e.Line, e.Reason, e.Text)
+}
+
+// ParseInput opens filename and parses it into a Graph.
+func ParseInput(filename string) (*Graph, int, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	return ParseReader(file)
+}
+
+// ParseReader parses a colony description from r, building the graph of
+// rooms and tunnels. It rejects duplicate room definitions, tunnels that
+// reference unknown rooms, self-loops, duplicate tunnels, reserved room
+// names (leading "L" or "#"), malformed room lines (including names that
+// would contain a space), non-positive or non-integer ant counts, and
+// inputs missing exactly one ##start and one ##end.
+func ParseReader(r io.Reader) (*Graph, int, error) {
+	scanner := bufio.NewScanner(r)
+	rooms := make(map[string]*Room)
+	tunnels := make(map[string][]string)
+	var startRoom *StartRoom
+	var endRoom *EndRoom
+	var numAnts int
+	var inStart, inEnd bool
+	var lineNum int
+
+	isKnownRoom := func(name string) bool {
+		if _, ok := rooms[name]; ok {
+			return true
+		}
+		return (startRoom != nil && startRoom.Name == name) || (endRoom != nil && endRoom.Name == name)
+	}
+
+	hasTunnel := func(a, b string) bool {
+		for _, n := range tunnels[a] {
+			if n == b {
+				return true
+			}
+		}
+		return false
+	}
+
+	// isIntLine reports whether s is an entire (possibly-signed) integer, so
+	// a line like "-3" is routed to the ant-count branch below instead of
+	// being misread as a tunnel by the "-" check.
+	isIntLine := func(s string) bool {
+		_, err := strconv.Atoi(s)
+		return err == nil
+	}
+
+	// isRoomShaped reports whether s parses as "<name> <int> <int>", the
+	// shape of a room definition line.
+	isRoomShaped := func(s string) bool {
+		var name string
+		var x, y int
+		n, err := fmt.Sscanf(s, "%s %d %d", &name, &x, &y)
+		return err == nil && n == 3
+	}
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		isMarker := strings.HasPrefix(line, "##start") || strings.HasPrefix(line, "##end")
+		// Any "#"-prefixed line is a comment, unless it also has the shape
+		// of a room definition ("#name x y"), in which case it's a room
+		// name colliding with the reserved "#" prefix and must fall through
+		// to be rejected below, not be skipped.
+		isComment := strings.HasPrefix(line, "#") && !isMarker && !isRoomShaped(line)
+		if line == "" || isComment {
+			continue
+		}
+
+		if isIntLine(line) {
+			// Parse number of ants. Checked ahead of the tunnel branch so a
+			// signed value like "-3" is diagnosed as a bad ant count rather
+			// than being split on "-" and mistaken for a tunnel.
+			n, _ := strconv.Atoi(line)
+			if n <= 0 {
+				return nil, 0, &ParseError{lineNum, line, "ant count must be positive"}
+			}
+			numAnts = n
+		} else if strings.Contains(line, "-") {
+			// Parse tunnel (link between rooms)
+			parts := strings.Split(line, "-")
+			if len(parts) != 2 {
+				return nil, 0, &ParseError{lineNum, line, "malformed tunnel"}
+			}
+			a, b := parts[0], parts[1]
+			if a == b {
+				return nil, 0, &ParseError{lineNum, line, "self-loop tunnel"}
+			}
+			if !isKnownRoom(a) || !isKnownRoom(b) {
+				return nil, 0, &ParseError{lineNum, line, "tunnel references unknown room"}
+			}
+			if hasTunnel(a, b) {
+				return nil, 0, &ParseError{lineNum, line, "duplicate tunnel"}
+			}
+			tunnels[a] = append(tunnels[a], b)
+			tunnels[b] = append(tunnels[b], a)
+		} else if strings.HasPrefix(line, "##start") {
+			if startRoom != nil {
+				return nil, 0, &ParseError{lineNum, line, "duplicate ##start marker"}
+			}
+			inStart = true
+			inEnd = false
+		} else if strings.HasPrefix(line, "##end") {
+			if endRoom != nil {
+				return nil, 0, &ParseError{lineNum, line, "duplicate ##end marker"}
+			}
+			inEnd = true
+			inStart = false
+		} else if strings.Contains(line, " ") {
+			// Parse room
+			var name string
+			var x, y int
+			_, err := fmt.Sscanf(line, "%s %d %d", &name, &x, &y)
+			if err != nil {
+				return nil, 0, &ParseError{lineNum, line, "malformed room"}
+			}
+			if strings.HasPrefix(name, "L") || strings.HasPrefix(name, "#") {
+				return nil, 0, &ParseError{lineNum, line, "room name reserved or invalid"}
+			}
+			if isKnownRoom(name) {
+				return nil, 0, &ParseError{lineNum, line, "duplicate room"}
+			}
+
+			// Assign to start or end room based on previous markers
+			if inStart {
+				startRoom = &StartRoom{Room{Name: name, X: x, Y: y, Occupied: false}}
+				inStart = false
+			} else if inEnd {
+				endRoom = &EndRoom{Room{Name: name, X: x, Y: y, Occupied: false}}
+				inEnd = false
+			} else {
+				rooms[name] = &Room{Name: name, X: x, Y: y, Occupied: false}
+			}
+		} else {
+			// A lone token that is not a whole integer (those were handled
+			// above), such as "abc" or "3.5", is not a valid ant count.
+			return nil, 0, &ParseError{lineNum, line, "malformed ant count"}
+		}
+	}
+
+	if startRoom == nil || endRoom == nil {
+		return nil, 0, ErrMissingStartEnd
+	}
+
+	return &Graph{Rooms: rooms, Tunnels: tunnels, Start: startRoom, End: endRoom}, numAnts, nil
+}
+
+// AllRooms returns every room in the colony, including ##start and ##end,
+// keyed by name.
+func (g *Graph) AllRooms() map[string]*Room {
+	rooms := make(map[string]*Room, len(g.Rooms)+2)
+	for name, r := range g.Rooms {
+		rooms[name] = r
+	}
+	rooms[g.Start.Name] = &g.Start.Room
+	rooms[g.End.Name] = &g.End.Room
+	return rooms
+}
@@ -0,0 +1,145 @@
+package colony
+
+import (
+	"os"
+	"testing"
+)
+
+// writeTempColony writes content to a temp file and returns its path,
+// registering cleanup with t.
+func writeTempColony(t *testing.T, content string) string {
+	t.Helper()
+	f, err := os.CreateTemp("", "colony-*.txt")
+	if err != nil {
+		t.Fatalf("CreateTemp: %v", err)
+	}
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatalf("WriteString: %v", err)
+	}
+	f.Close()
+	t.Cleanup(func() { os.Remove(f.Name()) })
+	return f.Name()
+}
+
+func TestParseInputRejectsMalformedColonies(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantReason string
+	}{
+		{
+			name:    "duplicate room",
+			content: "2\n##start\nstart 0 0\na 1 0\na 2 0\n##end\nend 3 0\nstart-a\na-end\n",
+		},
+		{
+			name:    "tunnel references unknown room",
+			content: "2\n##start\nstart 0 0\n##end\nend 1 0\nstart-ghost\n",
+		},
+		{
+			name:    "self-loop tunnel",
+			content: "2\n##start\nstart 0 0\na 1 0\n##end\nend 2 0\nstart-a\na-a\na-end\n",
+		},
+		{
+			name:    "duplicate tunnel",
+			content: "2\n##start\nstart 0 0\na 1 0\n##end\nend 2 0\nstart-a\nstart-a\na-end\n",
+		},
+		{
+			name:    "room name reserved with leading L",
+			content: "2\n##start\nstart 0 0\nL1 1 0\n##end\nend 2 0\nstart-L1\nL1-end\n",
+		},
+		{
+			name:    "room name reserved with leading #",
+			content: "2\n##start\nstart 0 0\n#room 1 0\n##end\nend 2 0\nstart-#room\n#room-end\n",
+		},
+		{
+			name:       "non-positive ant count",
+			content:    "0\n##start\nstart 0 0\n##end\nend 1 0\nstart-end\n",
+			wantReason: "ant count must be positive",
+		},
+		{
+			name:       "negative ant count",
+			content:    "-3\n##start\nstart 0 0\n##end\nend 1 0\nstart-end\n",
+			wantReason: "ant count must be positive",
+		},
+		{
+			name:       "room name with space",
+			content:    "2\n##start\nstart 0 0\nro om 1 0\n##end\nend 2 0\nstart-end\n",
+			wantReason: "malformed room",
+		},
+		{
+			name:    "duplicate ##start marker",
+			content: "2\n##start\nstart 0 0\n##start\nother 1 0\n##end\nend 2 0\nstart-end\n",
+		},
+		{
+			name:    "duplicate ##end marker",
+			content: "2\n##start\nstart 0 0\n##end\nend 1 0\n##end\nother 2 0\nstart-end\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempColony(t, tt.content)
+			_, _, err := ParseInput(path)
+			if err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			perr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("expected *ParseError, got %T: %v", err, err)
+			}
+			if tt.wantReason != "" && perr.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", perr.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestParseInputMissingStartOrEnd(t *testing.T) {
+	path := writeTempColony(t, "2\na 0 0\nb 1 0\na-b\n")
+	_, _, err := ParseInput(path)
+	if err != ErrMissingStartEnd {
+		t.Fatalf("expected ErrMissingStartEnd, got %v", err)
+	}
+}
+
+func TestParseInputAcceptsValidColony(t *testing.T) {
+	path := writeTempColony(t, "4\n##start\nstart 0 0\na 1 0\n##end\nend 2 0\nstart-a\na-end\n")
+	graph, numAnts, err := ParseInput(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if numAnts != 4 {
+		t.Errorf("numAnts = %d, want 4", numAnts)
+	}
+	if graph.Start.Name != "start" || graph.End.Name != "end" {
+		t.Errorf("unexpected start/end: %q / %q", graph.Start.Name, graph.End.Name)
+	}
+}
+
+func TestParseInputSkipsComments(t *testing.T) {
+	path := writeTempColony(t, "# the colony\n4\n##start\n# start room\nstart 0 0\na 1 0\n##end\nend 2 0\nstart-a\na-end\n")
+	graph, numAnts, err := ParseInput(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if numAnts != 4 {
+		t.Errorf("numAnts = %d, want 4", numAnts)
+	}
+	if graph.Start.Name != "start" || graph.End.Name != "end" {
+		t.Errorf("unexpected start/end: %q / %q", graph.Start.Name, graph.End.Name)
+	}
+}
+
+func TestParseInputSkipsCommentWithoutSpaceAfterHash(t *testing.T) {
+	path := writeTempColony(t, "#comment without leading space char after hash\n4\n##start\nstart 0 0\na 1 0\n##end\nend 2 0\nstart-a\na-end\n")
+	graph, numAnts, err := ParseInput(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if numAnts != 4 {
+		t.Errorf("numAnts = %d, want 4", numAnts)
+	}
+	if graph.Start.Name != "start" || graph.End.Name != "end" {
+		t.Errorf("unexpected start/end: %q / %q", graph.Start.Name, graph.End.Name)
+	}
+}
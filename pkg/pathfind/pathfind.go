@@ -0,0 +1,224 @@
+// Package pathfind finds and schedules ant routes through a colony.Graph.
+package pathfind
+
+import (
+	"container/list"
+	"strings"
+
+	"github.com/zainab49/lem-in/pkg/colony"
+)
+
+// BFS finds the shortest path from ##start to ##end using Breadth-First Search.
+func BFS(g *colony.Graph) ([]string, error) {
+	queue := list.New()
+	queue.PushBack([]string{g.Start.Name})
+	visited := make(map[string]bool)
+	visited[g.Start.Name] = true
+
+	for queue.Len() > 0 {
+		path := queue.Remove(queue.Front()).([]string)
+		room := path[len(path)-1]
+
+		if room == g.End.Name {
+			return path, nil
+		}
+
+		for _, neighbor := range g.Tunnels[room] {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				newPath := append([]string{}, path...)
+				newPath = append(newPath, neighbor)
+				queue.PushBack(newPath)
+			}
+		}
+	}
+
+	return nil, colony.ErrNoPath
+}
+
+// roomIn returns the node name for the "in" half of a split room, or the
+// room name unchanged for ##start and ##end, which are never split.
+func roomIn(g *colony.Graph, room string) string {
+	if room == g.Start.Name || room == g.End.Name {
+		return room
+	}
+	return room + "_in"
+}
+
+// roomOut returns the node name for the "out" half of a split room, or the
+// room name unchanged for ##start and ##end, which are never split.
+func roomOut(g *colony.Graph, room string) string {
+	if room == g.Start.Name || room == g.End.Name {
+		return room
+	}
+	return room + "_out"
+}
+
+// flowEdge is one directed edge in the residual graph. cap is the remaining
+// residual capacity; rev is the index, in graph[to], of this edge's paired
+// reverse edge. original marks edges we actually intended (room splits and
+// tunnels) as opposed to their auto-created, initially-zero-capacity reverse
+// counterpart — only original edges can appear in a reconstructed path.
+//
+// Tracking capacity per edge (rather than net capacity per node pair) is
+// what lets this handle tunnels correctly: an undirected tunnel becomes two
+// independent capacity-1 original edges, one in each direction, and each
+// needs its own reverse slot rather than sharing one keyed by node names —
+// otherwise a path that legitimately uses both directions of a tunnel (one
+// forward, one as part of canceling an earlier augmenting path) corrupts the
+// other direction's bookkeeping.
+type flowEdge struct {
+	to       string
+	cap      int
+	rev      int
+	original bool
+}
+
+type flowGraph map[string][]*flowEdge
+
+func (fg flowGraph) addEdge(from, to string, original bool) {
+	a := &flowEdge{to: to, cap: 1, rev: len(fg[to]), original: original}
+	b := &flowEdge{to: from, cap: 0, rev: len(fg[from])}
+	fg[from] = append(fg[from], a)
+	fg[to] = append(fg[to], b)
+}
+
+// bfsResidual finds a shortest augmenting path from start to end through
+// edges with positive residual capacity, returning the sequence of edges
+// taken (each pointing at the flowEdge it traversed).
+func bfsResidual(fg flowGraph, start, end string) []*flowEdge {
+	prev := map[string]*flowEdge{}
+	visited := map[string]bool{start: true}
+	queue := list.New()
+	queue.PushBack(start)
+
+	for queue.Len() > 0 {
+		node := queue.Remove(queue.Front()).(string)
+		if node == end {
+			var path []*flowEdge
+			for n := end; n != start; {
+				e := prev[n]
+				path = append([]*flowEdge{e}, path...)
+				n = reverseEndpoint(fg, n, e)
+			}
+			return path
+		}
+
+		for _, e := range fg[node] {
+			if e.cap > 0 && !visited[e.to] {
+				visited[e.to] = true
+				prev[e.to] = e
+				queue.PushBack(e.to)
+			}
+		}
+	}
+
+	return nil
+}
+
+// reverseEndpoint finds the node that edge e was traversed from, by locating
+// e's paired reverse edge and following it back to its own "to".
+func reverseEndpoint(fg flowGraph, arrivedAt string, e *flowEdge) string {
+	return fg[arrivedAt][e.rev].to
+}
+
+// DisjointPaths finds a maximal set of vertex-disjoint paths from ##start to
+// ##end. Each internal room is split into an "in" and an "out" node joined by
+// a capacity-1 edge, so no room can appear on more than one returned path.
+// Repeated BFS augmentation over the resulting residual graph (with reverse
+// edges so a later path can cancel part of an earlier one) yields the set of
+// paths that maximizes the number of disjoint routes, not just the first k
+// shortest paths found independently.
+func DisjointPaths(g *colony.Graph) ([][]string, error) {
+	fg := make(flowGraph)
+
+	for name := range g.Rooms {
+		fg.addEdge(roomIn(g, name), roomOut(g, name), true)
+	}
+
+	for from, neighbors := range g.Tunnels {
+		for _, to := range neighbors {
+			fg.addEdge(roomOut(g, from), roomIn(g, to), true)
+		}
+	}
+
+	start, end := g.Start.Name, g.End.Name
+
+	for {
+		path := bfsResidual(fg, start, end)
+		if path == nil {
+			break
+		}
+		for _, e := range path {
+			e.cap--
+			fg[e.to][e.rev].cap++
+		}
+	}
+
+	// Reconstruct paths by walking the original edges that now carry flow
+	// (cap dropped from 1 to 0), starting from ##start. Each edge is
+	// consumed as it's walked so no two reconstructed paths can share it —
+	// which, combined with every room having only one outgoing original
+	// edge with flow, is what keeps the paths vertex-disjoint.
+	var paths [][]string
+	for _, e := range fg[start] {
+		if !e.original || e.cap != 0 {
+			continue
+		}
+		e.cap = 1 // consume so a later reconstruction can't reuse it
+
+		path := []string{start}
+		node := e.to
+		for node != end {
+			room := strings.TrimSuffix(node, "_in")
+			path = append(path, room)
+
+			out := roomOut(g, room)
+			var next *flowEdge
+			for _, oe := range fg[out] {
+				if oe.original && oe.cap == 0 {
+					next = oe
+					break
+				}
+			}
+			next.cap = 1 // consume so a later reconstruction can't reuse it
+			node = next.to
+		}
+		path = append(path, end)
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return nil, colony.ErrNoPath
+	}
+
+	return paths, nil
+}
+
+// AssignAnts distributes numAnts ants across paths to minimize the total
+// number of turns the colony takes to empty. It greedily assigns each ant,
+// one at a time, to whichever path has the lowest estimated arrival turn
+// (the path's edge count plus the ants already queued on it) — the
+// water-filling solution to this scheduling problem. Returns, per path, the
+// number of ants assigned to it.
+func AssignAnts(paths [][]string, numAnts int) []int {
+	load := make([]int, len(paths))
+	assigned := make([]int, len(paths))
+
+	for i := range paths {
+		load[i] = len(paths[i]) - 1
+	}
+
+	for a := 0; a < numAnts; a++ {
+		best := 0
+		for i := 1; i < len(load); i++ {
+			if load[i] < load[best] {
+				best = i
+			}
+		}
+		assigned[best]++
+		load[best]++
+	}
+
+	return assigned
+}
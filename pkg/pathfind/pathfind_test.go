@@ -0,0 +1,148 @@
+package pathfind
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zainab49/lem-in/pkg/colony"
+)
+
+// mustParse parses a colony fixture and fails the test on error.
+func mustParse(t *testing.T, content string) *colony.Graph {
+	t.Helper()
+	g, _, err := colony.ParseReader(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseReader: %v", err)
+	}
+	return g
+}
+
+// turnsFor computes the total turns implied by an AssignAnts result: the
+// worst-case arrival turn across all paths.
+func turnsFor(paths [][]string, assignment []int) int {
+	worst := 0
+	for i, path := range paths {
+		turns := len(path) - 1 + assignment[i]
+		if turns > worst {
+			worst = turns
+		}
+	}
+	return worst
+}
+
+func TestAssignAntsSinglePath(t *testing.T) {
+	paths := [][]string{{"start", "a", "end"}}
+	assignment := AssignAnts(paths, 5)
+
+	if assignment[0] != 5 {
+		t.Fatalf("expected all 5 ants on the only path, got %v", assignment)
+	}
+}
+
+func TestAssignAntsEqualLengthPaths(t *testing.T) {
+	paths := [][]string{
+		{"start", "a", "end"},
+		{"start", "b", "end"},
+	}
+	assignment := AssignAnts(paths, 4)
+
+	for i, n := range assignment {
+		if n != 2 {
+			t.Errorf("path %d: expected 2 ants, got %d", i, n)
+		}
+	}
+	if got, want := turnsFor(paths, assignment), 4; got != want {
+		t.Errorf("turns = %d, want %d", got, want)
+	}
+}
+
+func TestAssignAntsPrefersShorterPath(t *testing.T) {
+	// One short path (1 edge) and one long path (3 edges), 4 ants.
+	// Hand-computed optimum: 3 ants on the short path (arrival turn 4),
+	// 1 ant on the long path (arrival turn 4) => 4 turns total.
+	paths := [][]string{
+		{"start", "end"},
+		{"start", "a", "b", "end"},
+	}
+	assignment := AssignAnts(paths, 4)
+
+	if got, want := turnsFor(paths, assignment), 4; got != want {
+		t.Errorf("turns = %d, want %d (assignment=%v)", got, want, assignment)
+	}
+	total := assignment[0] + assignment[1]
+	if total != 4 {
+		t.Fatalf("assignment does not account for all ants: %v", assignment)
+	}
+}
+
+func TestAssignAntsThreeUnevenPaths(t *testing.T) {
+	// Edge counts 1, 2, 4 and 6 ants. Water filling settles on 5 turns:
+	// path0 gets 4 ants (arrival 5), path1 gets 2 ants (arrival 4), path2
+	// gets 0 ants (its own length of 4 is already <= the 5-turn ceiling).
+	paths := [][]string{
+		{"start", "end"},
+		{"start", "a", "end"},
+		{"start", "b", "c", "d", "end"},
+	}
+	assignment := AssignAnts(paths, 6)
+
+	total := 0
+	for _, n := range assignment {
+		total += n
+	}
+	if total != 6 {
+		t.Fatalf("assignment does not account for all ants: %v", assignment)
+	}
+	if got, want := turnsFor(paths, assignment), 5; got != want {
+		t.Errorf("turns = %d, want %d (assignment=%v)", got, want, assignment)
+	}
+}
+
+func TestDisjointPathsNoSharedInternalRooms(t *testing.T) {
+	// Two independent branches from ##start to ##end.
+	g := mustParse(t, "4\n##start\nstart 0 0\na 1 0\nb 1 1\n##end\nend 2 0\nstart-a\na-end\nstart-b\nb-end\n")
+
+	paths, err := DisjointPaths(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 disjoint paths, got %d: %v", len(paths), paths)
+	}
+
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		for _, room := range path[1 : len(path)-1] { // exclude ##start/##end, which every path shares
+			if seen[room] {
+				t.Fatalf("room %q appears on more than one path: %v", room, paths)
+			}
+			seen[room] = true
+		}
+	}
+}
+
+func TestDisjointPathsCrossCancellation(t *testing.T) {
+	// The only shortest route, ##start-0-1-##end, blocks both of the two
+	// disjoint routes ##start-0-4-5-##end and ##start-2-3-1-##end. The first
+	// BFS always takes it, so the second path is found only by canceling
+	// its 0-1 hop through the reverse edge.
+	g := mustParse(t, "2\n##start\nstart 0 0\n0 1 0\n1 2 0\n2 1 1\n3 2 1\n4 1 2\n5 2 2\n##end\nend 3 0\nstart-0\n0-1\n1-end\nstart-2\n2-3\n3-1\n0-4\n4-5\n5-end\n")
+
+	paths, err := DisjointPaths(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 disjoint paths via cross-cancellation, got %d: %v", len(paths), paths)
+	}
+
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		for _, room := range path[1 : len(path)-1] {
+			if seen[room] {
+				t.Fatalf("room %q appears on more than one path: %v", room, paths)
+			}
+			seen[room] = true
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Package sim simulates ants moving across a set of assigned paths, turn by
+// turn, so callers can pipe the results to stdout, a TUI, or a test
+// assertion.
+package sim
+
+import "context"
+
+// Move is a single ant's move into a room during one simulation turn.
+type Move struct {
+	AntID int
+	Room  string
+}
+
+// ant tracks one ant's progress along its assigned path.
+type ant struct {
+	id   int
+	path []string
+	pos  int
+}
+
+// Run distributes ants across paths according to assignment (ants per path,
+// see pathfind.AssignAnts) and streams one []Move slice per turn on the
+// returned channel. The channel is closed once no ant can move further or
+// ctx is canceled.
+func Run(ctx context.Context, paths [][]string, assignment []int) <-chan []Move {
+	out := make(chan []Move)
+
+	go func() {
+		defer close(out)
+
+		var ants []*ant
+		id := 1
+		for i, path := range paths {
+			for n := 0; n < assignment[i]; n++ {
+				ants = append(ants, &ant{id: id, path: path, pos: 0})
+				id++
+			}
+		}
+
+		occupied := make(map[string]bool)
+		startCapacity := len(ants)
+
+		for {
+			moved := false
+			var moves []Move
+
+			for _, a := range ants {
+				if a.pos == 0 && startCapacity > 0 { // ant waiting in ##start
+					next := a.path[1]
+					if !occupied[next] || next == a.path[len(a.path)-1] {
+						startCapacity--
+						occupied[next] = true
+						a.pos++
+						moves = append(moves, Move{AntID: a.id, Room: next})
+						moved = true
+					}
+				} else if a.pos > 0 && a.pos < len(a.path)-1 { // ant mid-path
+					next := a.path[a.pos+1]
+					if !occupied[next] || next == a.path[len(a.path)-1] {
+						occupied[a.path[a.pos]] = false
+						occupied[next] = true
+						a.pos++
+						moves = append(moves, Move{AntID: a.id, Room: next})
+						moved = true
+					}
+				}
+			}
+
+			if !moved {
+				return
+			}
+
+			select {
+			case out <- moves:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,54 @@
+package sim
+
+import (
+	"context"
+	"testing"
+)
+
+// collect drains every turn's moves from Run into a single flat slice.
+func collect(paths [][]string, assignment []int) []Move {
+	var all []Move
+	for moves := range Run(context.Background(), paths, assignment) {
+		all = append(all, moves...)
+	}
+	return all
+}
+
+func TestRunSingleEdgePathDeliversAllAnts(t *testing.T) {
+	// A direct ##start-##end tunnel is a legitimate one-edge disjoint path;
+	// every ant assigned to it must still depart, not just the first.
+	paths := [][]string{{"##start", "##end"}}
+	assignment := []int{3}
+
+	moves := collect(paths, assignment)
+
+	delivered := make(map[int]bool)
+	for _, mv := range moves {
+		if mv.Room == "##end" {
+			delivered[mv.AntID] = true
+		}
+	}
+	if len(delivered) != 3 {
+		t.Fatalf("expected all 3 ants to reach ##end, got %d (moves=%v)", len(delivered), moves)
+	}
+}
+
+func TestRunMultiplePaths(t *testing.T) {
+	paths := [][]string{
+		{"##start", "a", "##end"},
+		{"##start", "b", "##end"},
+	}
+	assignment := []int{2, 2}
+
+	moves := collect(paths, assignment)
+
+	delivered := make(map[int]bool)
+	for _, mv := range moves {
+		if mv.Room == "##end" {
+			delivered[mv.AntID] = true
+		}
+	}
+	if len(delivered) != 4 {
+		t.Fatalf("expected all 4 ants to reach ##end, got %d (moves=%v)", len(delivered), moves)
+	}
+}